@@ -0,0 +1,62 @@
+// Package user is the application layer for the user aggregate: it
+// orchestrates domain/user use-cases and exposes DTOs to callers instead of
+// domain entities directly.
+package user
+
+import domain "hammy/domain/user"
+
+// UserDTO is the application-layer representation of a User.
+type UserDTO struct {
+	ID    string
+	Name  string
+	Email string
+}
+
+// UserService orchestrates user use-cases against a domain.UserRepository.
+type UserService struct {
+	repo domain.UserRepository
+}
+
+// NewUserService returns a UserService backed by repo.
+func NewUserService(repo domain.UserRepository) *UserService {
+	return &UserService{repo: repo}
+}
+
+// Register validates and persists a new user, returning its DTO.
+func (s *UserService) Register(name, email string) (*UserDTO, error) {
+	domainEmail, err := domain.NewEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u, err := domain.NewUser(name, domainEmail)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(u); err != nil {
+		return nil, err
+	}
+	return toDTO(u), nil
+}
+
+// GetByID looks up a user by ID and returns its DTO.
+func (s *UserService) GetByID(id string) (*UserDTO, error) {
+	u, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return toDTO(u), nil
+}
+
+// FindByID and Create keep the signatures the pre-refactor UserService
+// exposed, as a seam for callers migrating onto the application layer.
+func (s *UserService) FindByID(id string) (*domain.User, error) {
+	return s.repo.FindByID(id)
+}
+
+func (s *UserService) Create(u *domain.User) error {
+	return s.repo.Create(u)
+}
+
+func toDTO(u *domain.User) *UserDTO {
+	return &UserDTO{ID: u.ID, Name: u.Name, Email: string(u.Email)}
+}
@@ -0,0 +1,33 @@
+package user_test
+
+import (
+	"testing"
+
+	appuser "hammy/application/user"
+	"hammy/infrastructure/persistence"
+)
+
+func TestUserServiceRegisterAndGetByID(t *testing.T) {
+	svc := appuser.NewUserService(persistence.NewInMemoryUserRepository())
+
+	registered, err := svc.Register("Ada", "ada@example.com")
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	got, err := svc.GetByID(registered.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.Name != "Ada" {
+		t.Fatalf("got %q", got.Name)
+	}
+}
+
+func TestUserServiceRegisterInvalidEmail(t *testing.T) {
+	svc := appuser.NewUserService(persistence.NewInMemoryUserRepository())
+
+	if _, err := svc.Register("Ada", "not-an-email"); err == nil {
+		t.Fatal("expected error for invalid email")
+	}
+}
@@ -0,0 +1,55 @@
+// Package auth resolves outbound HTTP credentials from the user's netrc
+// file, the same source `go get` itself consults for private module hosts.
+package auth
+
+import (
+	"log"
+	"net/http"
+)
+
+// AddCredentials consults the cached netrc table for req.URL.Hostname() (the
+// host without any port) and, if a
+// matching machine entry exists and the request does not already carry an
+// Authorization header, sets basic auth from it. Credential lookup is
+// best-effort: a failure to load the netrc file (missing permissions, a
+// transient I/O error) is logged and treated as "no credentials", not a
+// reason to fail the request.
+func AddCredentials(req *http.Request) error {
+	netrc, err := Load()
+	if err != nil {
+		log.Printf("auth: load netrc: %v; proceeding without credentials", err)
+		netrc = nil
+	}
+	return addCredentials(req, netrc)
+}
+
+// addCredentials does the actual work for AddCredentials against an
+// already-parsed Netrc, so tests can exercise it without going through the
+// process-wide Load cache.
+func addCredentials(req *http.Request, netrc *Netrc) error {
+	if req.Header.Get("Authorization") != "" {
+		return nil
+	}
+
+	entry, ok := netrc.Lookup(req.URL.Hostname())
+	if !ok {
+		return nil
+	}
+
+	req.SetBasicAuth(entry.Login, entry.Password)
+	return nil
+}
+
+// StripOnSchemeDowngrade removes credentials from req when it is a redirect
+// hop that downgrades from https to http, mirroring the security policy Go's
+// own HTTP transport applies to the Authorization header.
+func StripOnSchemeDowngrade(req *http.Request, via []*http.Request) error {
+	if len(via) == 0 {
+		return nil
+	}
+	prev := via[len(via)-1]
+	if prev.URL.Scheme == "https" && req.URL.Scheme != "https" {
+		req.Header.Del("Authorization")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddCredentialsDoesNotOverrideExistingHeader(t *testing.T) {
+	netrc := &Netrc{machines: map[string]Entry{
+		"example.com": {Login: "ada", Password: "secret"},
+	}}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer existing-token")
+
+	if err := addCredentials(req, netrc); err != nil {
+		t.Fatalf("addCredentials: %v", err)
+	}
+
+	if got := req.Header.Get("Authorization"); got != "Bearer existing-token" {
+		t.Fatalf("Authorization = %q, want unchanged", got)
+	}
+}
+
+func TestAddCredentialsSetsBasicAuthFromMatch(t *testing.T) {
+	netrc := &Netrc{machines: map[string]Entry{
+		"example.com": {Login: "ada", Password: "secret"},
+	}}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if err := addCredentials(req, netrc); err != nil {
+		t.Fatalf("addCredentials: %v", err)
+	}
+
+	login, password, ok := req.BasicAuth()
+	if !ok || login != "ada" || password != "secret" {
+		t.Fatalf("BasicAuth = (%q, %q, %v)", login, password, ok)
+	}
+}
+
+func TestStripOnSchemeDowngradeAcrossRedirect(t *testing.T) {
+	var gotAuth string
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer httpServer.Close()
+
+	httpsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, httpServer.URL, http.StatusFound)
+	}))
+	defer httpsServer.Close()
+
+	client := httpsServer.Client()
+	client.CheckRedirect = StripOnSchemeDowngrade
+
+	req, err := http.NewRequest(http.MethodGet, httpsServer.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.SetBasicAuth("ada", "secret")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if gotAuth != "" {
+		t.Fatalf("Authorization leaked across https->http redirect: %q", gotAuth)
+	}
+}
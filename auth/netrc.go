@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Entry holds the login/password pair configured for a single netrc machine.
+type Entry struct {
+	Login    string
+	Password string
+}
+
+// Netrc is a parsed netrc file, keyed by machine (host) name.
+type Netrc struct {
+	machines map[string]Entry
+}
+
+// Lookup returns the Entry configured for host, falling back to the
+// netrc "default" machine if host has no exact entry.
+func (n *Netrc) Lookup(host string) (Entry, bool) {
+	if n == nil {
+		return Entry{}, false
+	}
+	if e, ok := n.machines[host]; ok {
+		return e, true
+	}
+	e, ok := n.machines["default"]
+	return e, ok
+}
+
+var (
+	mu     sync.Mutex
+	cached *Netrc
+)
+
+// Load parses $NETRC (or the platform default netrc path) and caches the
+// result for the lifetime of the process. A failed parse is not cached, so
+// a transient error (e.g. a permissions problem) doesn't poison every
+// later call.
+func Load() (*Netrc, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if cached != nil {
+		return cached, nil
+	}
+
+	n, err := load(netrcPath())
+	if err != nil {
+		return nil, err
+	}
+	cached = n
+	return cached, nil
+}
+
+func netrcPath() string {
+	if p := os.Getenv("NETRC"); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	name := ".netrc"
+	if runtime.GOOS == "windows" {
+		name = "_netrc"
+	}
+	return filepath.Join(home, name)
+}
+
+func load(path string) (*Netrc, error) {
+	n := &Netrc{machines: map[string]Entry{}}
+	if path == "" {
+		return n, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return n, nil
+		}
+		return nil, fmt.Errorf("auth: open netrc: %w", err)
+	}
+	defer f.Close()
+
+	contents, err := readAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("auth: read netrc: %w", err)
+	}
+	fields := strings.Fields(contents)
+
+	var machine string
+	var entry Entry
+	flush := func() {
+		if machine != "" {
+			n.machines[machine] = entry
+		}
+		machine, entry = "", Entry{}
+	}
+
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case "machine":
+			flush()
+			if i+1 < len(fields) {
+				i++
+				machine = fields[i]
+			}
+		case "login":
+			if i+1 < len(fields) {
+				i++
+				entry.Login = fields[i]
+			}
+		case "password":
+			if i+1 < len(fields) {
+				i++
+				entry.Password = fields[i]
+			}
+		case "default":
+			flush()
+			machine = "default"
+		}
+	}
+	flush()
+
+	return n, nil
+}
+
+func readAll(f *os.File) (string, error) {
+	var sb strings.Builder
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		sb.WriteString(scanner.Text())
+		sb.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
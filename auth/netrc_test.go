@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	n, err := load(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := n.Lookup("example.com"); ok {
+		t.Fatal("expected no entries for a missing netrc file")
+	}
+}
+
+func TestLoadParsesMachines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "netrc")
+	contents := "machine example.com\nlogin ada\npassword secret\n\nmachine other.com login bob password hunter2\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("write netrc: %v", err)
+	}
+
+	n, err := load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	entry, ok := n.Lookup("example.com")
+	if !ok {
+		t.Fatal("expected an entry for example.com")
+	}
+	if entry.Login != "ada" || entry.Password != "secret" {
+		t.Fatalf("got %+v", entry)
+	}
+
+	entry, ok = n.Lookup("other.com")
+	if !ok {
+		t.Fatal("expected an entry for other.com")
+	}
+	if entry.Login != "bob" || entry.Password != "hunter2" {
+		t.Fatalf("got %+v", entry)
+	}
+
+	if _, ok := n.Lookup("unknown.com"); ok {
+		t.Fatal("expected no entry for an unlisted machine")
+	}
+}
+
+func TestLoadDefaultMachineIsLookupFallback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "netrc")
+	contents := "machine example.com login ada password secret\ndefault login anon password anon\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("write netrc: %v", err)
+	}
+
+	n, err := load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	entry, ok := n.Lookup("example.com")
+	if !ok || entry.Login != "ada" {
+		t.Fatalf("exact match got %+v, %v", entry, ok)
+	}
+
+	entry, ok = n.Lookup("unlisted.com")
+	if !ok {
+		t.Fatal("expected the default machine to back a host with no exact match")
+	}
+	if entry.Login != "anon" || entry.Password != "anon" {
+		t.Fatalf("got %+v", entry)
+	}
+}
+
+func TestLoadDirectoryReturnsError(t *testing.T) {
+	if _, err := load(t.TempDir()); err == nil {
+		t.Fatal("expected an error reading a directory as a netrc file")
+	}
+}
+
+func TestLoadDoesNotCacheFailure(t *testing.T) {
+	defer func() {
+		mu.Lock()
+		cached = nil
+		mu.Unlock()
+	}()
+
+	mu.Lock()
+	cached = nil
+	mu.Unlock()
+
+	t.Setenv("NETRC", t.TempDir()) // a directory, so load() errors
+
+	if _, err := Load(); err == nil {
+		t.Fatal("expected Load to surface the read error")
+	}
+
+	path := filepath.Join(t.TempDir(), "netrc")
+	if err := os.WriteFile(path, []byte("machine example.com login ada password secret\n"), 0o600); err != nil {
+		t.Fatalf("write netrc: %v", err)
+	}
+	t.Setenv("NETRC", path)
+
+	n, err := Load()
+	if err != nil {
+		t.Fatalf("Load after fixing NETRC: %v", err)
+	}
+	if _, ok := n.Lookup("example.com"); !ok {
+		t.Fatal("expected Load to succeed once NETRC points at a readable file")
+	}
+}
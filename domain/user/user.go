@@ -0,0 +1,66 @@
+// Package user holds the User aggregate: the entity itself, its value
+// objects, and the repository boundary the application layer depends on.
+// It must never import net/http or any infrastructure package.
+package user
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Email is a value object enforcing a minimal validity invariant.
+type Email string
+
+// NewEmail validates raw and returns it as an Email.
+func NewEmail(raw string) (Email, error) {
+	email := Email(raw)
+	if err := email.Validate(); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// Validate enforces Email's shape invariant, independent of where the
+// value came from.
+func (e Email) Validate() error {
+	if e == "" || !strings.Contains(string(e), "@") {
+		return fmt.Errorf("user: invalid email %q", string(e))
+	}
+	return nil
+}
+
+// User is the domain entity for a registered user.
+type User struct {
+	ID    string
+	Name  string
+	Email Email
+}
+
+// NewUser builds a User and validates its invariants.
+func NewUser(name string, email Email) (*User, error) {
+	u := &User{Name: name, Email: email}
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *User) FullName() string {
+	return u.Name
+}
+
+// Validate enforces the entity's invariants independent of any storage
+// concerns.
+func (u *User) Validate() error {
+	if u.Name == "" {
+		return fmt.Errorf("user: name required")
+	}
+	return u.Email.Validate()
+}
+
+// UserRepository is the persistence boundary for User. Infrastructure
+// packages provide the concrete implementation.
+type UserRepository interface {
+	FindByID(id string) (*User, error)
+	Create(u *User) error
+}
@@ -0,0 +1,37 @@
+package user
+
+import "testing"
+
+func TestNewEmail(t *testing.T) {
+	if _, err := NewEmail("not-an-email"); err == nil {
+		t.Fatal("expected error for email without @")
+	}
+	email, err := NewEmail("a@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if email != "a@example.com" {
+		t.Fatalf("got %q", email)
+	}
+}
+
+func TestNewUserValidation(t *testing.T) {
+	if _, err := NewUser("", "a@example.com"); err == nil {
+		t.Fatal("expected error for missing name")
+	}
+	u, err := NewUser("Ada", "ada@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u.FullName() != "Ada" {
+		t.Fatalf("got %q", u.FullName())
+	}
+}
+
+func TestNewUserRejectsInvalidEmailShape(t *testing.T) {
+	// Email(...) bypasses NewEmail's validation; NewUser/Validate must
+	// still catch an invalid shape rather than only checking presence.
+	if _, err := NewUser("Ada", Email("not-an-email")); err == nil {
+		t.Fatal("expected error for email missing @")
+	}
+}
@@ -0,0 +1,68 @@
+// Package dump renders the on-the-wire representation of httpclient
+// Requests and Responses, for debugging and for pinning request shapes
+// down in golden test fixtures.
+//
+// Like httpclient/validate, it declares small interfaces satisfied by
+// *httpclient.Request and *httpclient.Response instead of importing
+// hammy/httpclient directly, so the two packages don't form an import
+// cycle (httpclient imports dump, not the other way around).
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+)
+
+// DumpableRequest is satisfied by *httpclient.Request.
+type DumpableRequest interface {
+	// BuildHTTPRequest builds the *http.Request the client would send,
+	// without dispatching it. Building a Request consumes any FileField
+	// readers it carries, so call this at most once per Request.
+	BuildHTTPRequest() (*http.Request, error)
+}
+
+// DumpableResponse is satisfied by *httpclient.Response.
+type DumpableResponse interface {
+	ResponseStatus() string
+	ResponseHeader() http.Header
+	ResponseBody() ([]byte, error)
+}
+
+// DumpRequest renders req's on-the-wire representation, including any
+// multipart file parts, form-encoded Data, JSON body, and cookies. Pass
+// body=false to dump headers only.
+func DumpRequest(req DumpableRequest, body bool) ([]byte, error) {
+	httpReq, err := req.BuildHTTPRequest()
+	if err != nil {
+		return nil, fmt.Errorf("dump: build request: %w", err)
+	}
+	out, err := httputil.DumpRequestOut(httpReq, body)
+	if err != nil {
+		return nil, fmt.Errorf("dump: dump request: %w", err)
+	}
+	return out, nil
+}
+
+// DumpResponse renders resp's on-the-wire representation. Pass body=false
+// to dump the status line and headers only.
+func DumpResponse(resp DumpableResponse, body bool) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "HTTP/1.1 %s\r\n", resp.ResponseStatus())
+	if err := resp.ResponseHeader().Write(&buf); err != nil {
+		return nil, fmt.Errorf("dump: write headers: %w", err)
+	}
+	buf.WriteString("\r\n")
+
+	if body {
+		b, err := resp.ResponseBody()
+		if err != nil {
+			return nil, fmt.Errorf("dump: read body: %w", err)
+		}
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,60 @@
+package dump_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"hammy/httpclient/dump"
+)
+
+type fakeRequest struct{ req *http.Request }
+
+func (r fakeRequest) BuildHTTPRequest() (*http.Request, error) { return r.req, nil }
+
+type fakeResponse struct {
+	status string
+	header http.Header
+	body   []byte
+}
+
+func (r fakeResponse) ResponseStatus() string        { return r.status }
+func (r fakeResponse) ResponseHeader() http.Header   { return r.header }
+func (r fakeResponse) ResponseBody() ([]byte, error) { return r.body, nil }
+
+func TestDumpRequestIncludesMethodAndURL(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/users", strings.NewReader("name=ada"))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	out, err := dump.DumpRequest(fakeRequest{req}, true)
+	if err != nil {
+		t.Fatalf("DumpRequest: %v", err)
+	}
+
+	if !strings.Contains(string(out), "POST /users HTTP/1.1") {
+		t.Fatalf("dump missing request line:\n%s", out)
+	}
+	if !strings.Contains(string(out), "name=ada") {
+		t.Fatalf("dump missing body:\n%s", out)
+	}
+}
+
+func TestDumpResponse(t *testing.T) {
+	resp := fakeResponse{
+		status: "200 OK",
+		header: http.Header{"Content-Type": []string{"application/json"}},
+		body:   []byte(`{"ok":true}`),
+	}
+
+	out, err := dump.DumpResponse(resp, true)
+	if err != nil {
+		t.Fatalf("DumpResponse: %v", err)
+	}
+
+	want := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n{\"ok\":true}"
+	if string(out) != want {
+		t.Fatalf("got:\n%q\nwant:\n%q", out, want)
+	}
+}
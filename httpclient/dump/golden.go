@@ -0,0 +1,47 @@
+package dump
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update dump golden fixtures instead of checking them")
+
+// Golden dumps req and compares it against testdata/<test name>.http,
+// rewriting the fixture instead when the test binary is run with -update.
+func Golden(t *testing.T, req DumpableRequest) {
+	t.Helper()
+
+	got, err := DumpRequest(req, true)
+	if err != nil {
+		t.Fatalf("dump.Golden: %v", err)
+	}
+
+	path := filepath.Join("testdata", sanitize(t.Name())+".http")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("dump.Golden: mkdir testdata: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("dump.Golden: write %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("dump.Golden: read %s (run with -update to create it): %v", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("dump.Golden: %s does not match\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+func sanitize(name string) string {
+	return strings.NewReplacer("/", "_", " ", "_").Replace(name)
+}
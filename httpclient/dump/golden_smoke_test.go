@@ -0,0 +1,17 @@
+package dump_test
+
+import (
+	"net/http"
+	"testing"
+
+	"hammy/httpclient/dump"
+)
+
+func TestGoldenLocksDownRequestShape(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/users", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	dump.Golden(t, fakeRequest{req})
+}
@@ -0,0 +1,329 @@
+// Package httpclient provides a fluent HTTP client in the spirit of Python's
+// Requests library: build a Request with the fields you need, then fire it
+// with Get/Post/Put/Delete/Patch and read the result off the Response.
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"hammy/auth"
+	"hammy/httpclient/dump"
+	"hammy/httpclient/validate"
+)
+
+// DefaultTimeout is used when a Request does not set one explicitly.
+const DefaultTimeout = 30 * time.Second
+
+// FileField describes a single file part to attach to a multipart request.
+type FileField struct {
+	FieldName string
+	FileName  string
+	Content   io.Reader
+}
+
+// Request describes a single outbound HTTP call.
+type Request struct {
+	client *Client
+
+	Method  string
+	URL     string
+	Headers map[string]string
+	Cookies map[string]string
+	Params  map[string]string
+	Data    url.Values
+	JSON    any
+	Files   []FileField
+	Timeout time.Duration
+}
+
+// Response wraps the *http.Response returned by a Client call and caches the
+// body so it can be read more than once via Json/Text/Bytes.
+type Response struct {
+	Raw  *http.Response
+	body []byte
+}
+
+// RequestMiddleware mutates an outbound *http.Request before it is sent. A
+// non-nil error aborts the call.
+type RequestMiddleware func(*http.Request) error
+
+// Client issues Requests using an underlying *http.Client.
+type Client struct {
+	HTTPClient  *http.Client
+	Middlewares []RequestMiddleware
+
+	// SkipValidation disables the automatic validate.Request check Do runs
+	// on every outbound Request. Most callers should leave this false.
+	SkipValidation bool
+
+	// Trace, when set, receives a dump of every request/response pair the
+	// client sends: the request as sent (after middlewares), rendered with
+	// httputil.DumpRequestOut, followed by the dump.DumpResponse output.
+	Trace io.Writer
+}
+
+// New returns a Client backed by a default *http.Client. Outbound requests
+// automatically pick up netrc credentials via auth.AddCredentials, and the
+// Authorization header is dropped on any https-to-http redirect.
+func New() *Client {
+	return &Client{
+		HTTPClient: &http.Client{
+			CheckRedirect: auth.StripOnSchemeDowngrade,
+		},
+		Middlewares: []RequestMiddleware{auth.AddCredentials},
+	}
+}
+
+// NewRequest returns a Request bound to this Client, ready to be configured
+// and dispatched with Get/Post/Put/Delete/Patch.
+func (c *Client) NewRequest() *Request {
+	return &Request{client: c}
+}
+
+// Get, Post, Put, Delete, Patch dispatch the Request, overwriting r.Method
+// and r.URL before sending.
+func (r *Request) Get(url string) (*Response, error)    { return r.send(http.MethodGet, url) }
+func (r *Request) Post(url string) (*Response, error)   { return r.send(http.MethodPost, url) }
+func (r *Request) Put(url string) (*Response, error)    { return r.send(http.MethodPut, url) }
+func (r *Request) Delete(url string) (*Response, error) { return r.send(http.MethodDelete, url) }
+func (r *Request) Patch(url string) (*Response, error)  { return r.send(http.MethodPatch, url) }
+
+func (r *Request) send(method, rawURL string) (*Response, error) {
+	r.Method = method
+	r.URL = rawURL
+	return r.client.Do(r)
+}
+
+// The methods below satisfy validate.Validatable so that
+// hammy/httpclient/validate can inspect and normalize a Request without
+// hammy/httpclient importing it the other way around.
+
+func (r *Request) RequestMethod() string         { return r.Method }
+func (r *Request) RequestURL() string            { return r.URL }
+func (r *Request) SetURL(url string)             { r.URL = url }
+func (r *Request) HasData() bool                 { return len(r.Data) > 0 }
+func (r *Request) HasJSON() bool                 { return r.JSON != nil }
+func (r *Request) RequestTimeout() time.Duration { return r.Timeout }
+func (r *Request) SetTimeout(d time.Duration)    { r.Timeout = d }
+
+func (r *Request) RequestHeader(key string) string {
+	if r.Headers == nil {
+		return ""
+	}
+	return r.Headers[key]
+}
+
+func (r *Request) SetHeader(key, value string) {
+	if r.Headers == nil {
+		r.Headers = map[string]string{}
+	}
+	r.Headers[key] = value
+}
+
+// BuildHTTPRequest builds the *http.Request Do would send, without
+// dispatching it. It satisfies dump.DumpableRequest.
+func (r *Request) BuildHTTPRequest() (*http.Request, error) {
+	return r.build()
+}
+
+// Do builds the underlying *http.Request from req and executes it, always
+// checking the error from http.Client.Do before touching the response body.
+func (c *Client) Do(req *Request) (*Response, error) {
+	if !c.SkipValidation {
+		if err := validate.Request(req); err != nil {
+			return nil, err
+		}
+	}
+
+	httpReq, err := req.build()
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: build request: %w", err)
+	}
+
+	timeout := req.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(httpReq.Context(), timeout)
+	defer cancel()
+	httpReq = httpReq.WithContext(ctx)
+
+	for _, mw := range c.Middlewares {
+		if err := mw(httpReq); err != nil {
+			return nil, fmt.Errorf("httpclient: middleware: %w", err)
+		}
+	}
+
+	if c.Trace != nil {
+		if reqDump, err := httputil.DumpRequestOut(httpReq, true); err == nil {
+			c.Trace.Write(reqDump)
+		}
+	}
+
+	resp, err := c.httpClient().Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: read body: %w", err)
+	}
+
+	result := &Response{Raw: resp, body: respBody}
+
+	if c.Trace != nil {
+		if respDump, err := dump.DumpResponse(result, true); err == nil {
+			c.Trace.Write(respDump)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (r *Request) build() (*http.Request, error) {
+	body, contentType, err := r.body()
+	if err != nil {
+		return nil, err
+	}
+
+	fullURL, err := r.urlWithParams()
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequest(r.Method, fullURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range r.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	if contentType != "" && httpReq.Header.Get("Content-Type") == "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	for name, value := range r.Cookies {
+		httpReq.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+
+	return httpReq, nil
+}
+
+func (r *Request) urlWithParams() (string, error) {
+	if len(r.Params) == 0 {
+		return r.URL, nil
+	}
+	parsed, err := url.Parse(r.URL)
+	if err != nil {
+		return "", fmt.Errorf("httpclient: parse url: %w", err)
+	}
+	q := parsed.Query()
+	for k, v := range r.Params {
+		q.Set(k, v)
+	}
+	parsed.RawQuery = q.Encode()
+	return parsed.String(), nil
+}
+
+func (r *Request) body() (io.Reader, string, error) {
+	switch {
+	case len(r.Files) > 0:
+		return r.multipartBody()
+	case r.JSON != nil:
+		buf, err := json.Marshal(r.JSON)
+		if err != nil {
+			return nil, "", fmt.Errorf("httpclient: marshal json: %w", err)
+		}
+		return bytes.NewReader(buf), "application/json", nil
+	case len(r.Data) > 0:
+		return strings.NewReader(r.Data.Encode()), "application/x-www-form-urlencoded", nil
+	default:
+		return nil, "", nil
+	}
+}
+
+func (r *Request) multipartBody() (io.Reader, string, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	for key, values := range r.Data {
+		for _, v := range values {
+			if err := writer.WriteField(key, v); err != nil {
+				return nil, "", fmt.Errorf("httpclient: write field %s: %w", key, err)
+			}
+		}
+	}
+
+	for _, f := range r.Files {
+		part, err := writer.CreateFormFile(f.FieldName, f.FileName)
+		if err != nil {
+			return nil, "", fmt.Errorf("httpclient: create form file %s: %w", f.FieldName, err)
+		}
+		if _, err := io.Copy(part, f.Content); err != nil {
+			return nil, "", fmt.Errorf("httpclient: write file %s: %w", f.FieldName, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("httpclient: close multipart writer: %w", err)
+	}
+
+	return buf, writer.FormDataContentType(), nil
+}
+
+// Json decodes the response body into v.
+func (resp *Response) Json(v any) error {
+	if resp == nil {
+		return fmt.Errorf("httpclient: nil response")
+	}
+	return json.Unmarshal(resp.body, v)
+}
+
+// Text returns the response body as a string.
+func (resp *Response) Text() (string, error) {
+	if resp == nil {
+		return "", fmt.Errorf("httpclient: nil response")
+	}
+	return string(resp.body), nil
+}
+
+// Bytes returns the raw response body.
+func (resp *Response) Bytes() ([]byte, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("httpclient: nil response")
+	}
+	return resp.body, nil
+}
+
+// ResponseStatus, ResponseHeader, ResponseBody satisfy dump.DumpableResponse.
+func (resp *Response) ResponseStatus() string        { return resp.Raw.Status }
+func (resp *Response) ResponseHeader() http.Header   { return resp.Raw.Header }
+func (resp *Response) ResponseBody() ([]byte, error) { return resp.Bytes() }
+
+// Close releases the underlying response body, if any. It is always safe to
+// call, even on a nil Response or one whose Raw response is nil.
+func (resp *Response) Close() error {
+	if resp == nil || resp.Raw == nil || resp.Raw.Body == nil {
+		return nil
+	}
+	return resp.Raw.Body.Close()
+}
@@ -0,0 +1,193 @@
+package httpclient_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"hammy/httpclient"
+)
+
+func TestClientGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	resp, err := client.NewRequest().Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Close()
+
+	body, err := resp.Text()
+	if err != nil {
+		t.Fatalf("Text: %v", err)
+	}
+	if body != "ok" {
+		t.Fatalf("got %q", body)
+	}
+}
+
+func TestClientRejectsMissingURL(t *testing.T) {
+	client := httpclient.New()
+	if _, err := client.NewRequest().Get(""); err == nil {
+		t.Fatal("expected error for missing url")
+	}
+}
+
+func TestResponseCloseIsNilSafe(t *testing.T) {
+	var resp *httpclient.Response
+	if err := resp.Close(); err != nil {
+		t.Fatalf("Close on nil response: %v", err)
+	}
+}
+
+func TestClientPostJSON(t *testing.T) {
+	type payload struct {
+		Name string `json:"name"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		var in payload
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload{Name: "echo:" + in.Name})
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	req := client.NewRequest()
+	req.JSON = payload{Name: "ada"}
+
+	resp, err := req.Post(server.URL)
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	defer resp.Close()
+
+	var out payload
+	if err := resp.Json(&out); err != nil {
+		t.Fatalf("Json: %v", err)
+	}
+	if out.Name != "echo:ada" {
+		t.Fatalf("got %+v", out)
+	}
+}
+
+func TestClientPostFormData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm: %v", err)
+		}
+		if got := r.PostForm.Get("name"); got != "ada" {
+			t.Errorf("form name = %q", got)
+		}
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	req := client.NewRequest()
+	req.Data = url.Values{"name": {"ada"}}
+
+	resp, err := req.Post(server.URL)
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	defer resp.Close()
+}
+
+func TestClientGetWithParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("q"); got != "go" {
+			t.Errorf("query q = %q, want go", got)
+		}
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	req := client.NewRequest()
+	req.Params = map[string]string{"q": "go"}
+
+	resp, err := req.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Close()
+}
+
+func TestClientPostFileUpload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(10 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm: %v", err)
+		}
+		if got := r.FormValue("field"); got != "value" {
+			t.Errorf("field = %q, want value", got)
+		}
+
+		file, header, err := r.FormFile("upload")
+		if err != nil {
+			t.Fatalf("FormFile: %v", err)
+		}
+		defer file.Close()
+		if header.Filename != "a.txt" {
+			t.Errorf("filename = %q", header.Filename)
+		}
+		content, err := io.ReadAll(file)
+		if err != nil {
+			t.Fatalf("read file part: %v", err)
+		}
+		if string(content) != "hello" {
+			t.Errorf("file content = %q", content)
+		}
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	req := client.NewRequest()
+	req.Data = url.Values{"field": {"value"}}
+	req.Files = []httpclient.FileField{
+		{FieldName: "upload", FileName: "a.txt", Content: strings.NewReader("hello")},
+	}
+
+	resp, err := req.Post(server.URL)
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	defer resp.Close()
+}
+
+func TestResponseBytes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("raw-bytes"))
+	}))
+	defer server.Close()
+
+	client := httpclient.New()
+	resp, err := client.NewRequest().Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Close()
+
+	b, err := resp.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	if string(b) != "raw-bytes" {
+		t.Fatalf("got %q", b)
+	}
+}
@@ -0,0 +1,94 @@
+// Package validate inspects an httpclient.Request before it is dispatched,
+// normalizing a few fields and rejecting invalid or conflicting ones.
+//
+// It is imported by hammy/httpclient, not the other way around: Validatable
+// is declared here and satisfied implicitly by *httpclient.Request so that
+// the two packages don't form an import cycle.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Sentinel errors returned by Request. Use errors.Is to check for a
+// specific one.
+var (
+	ErrMissingURL      = errors.New("validate: missing url")
+	ErrInvalidMethod   = errors.New("validate: invalid method")
+	ErrConflictingBody = errors.New("validate: Data and JSON both set")
+)
+
+// DefaultTimeout and DefaultUserAgent are applied to a Request that leaves
+// the corresponding field unset.
+const (
+	DefaultTimeout   = 30 * time.Second
+	DefaultUserAgent = "hammy-httpclient"
+)
+
+var validMethods = map[string]bool{
+	"GET": true, "POST": true, "PUT": true, "DELETE": true,
+	"PATCH": true, "HEAD": true, "OPTIONS": true,
+}
+
+// Validatable is the subset of httpclient.Request that Request needs to
+// inspect and normalize.
+type Validatable interface {
+	RequestMethod() string
+	RequestURL() string
+	SetURL(string)
+	HasData() bool
+	HasJSON() bool
+	RequestTimeout() time.Duration
+	SetTimeout(time.Duration)
+	RequestHeader(key string) string
+	SetHeader(key, value string)
+}
+
+// Request validates req, normalizing its URL and applying default Timeout
+// and User-Agent when unset.
+func Request(req Validatable) error {
+	full, err := normalizeURL(req.RequestURL())
+	if err != nil {
+		return err
+	}
+
+	method := req.RequestMethod()
+	if method == "" {
+		method = "GET"
+	}
+	if !validMethods[method] {
+		return fmt.Errorf("%w: %q", ErrInvalidMethod, method)
+	}
+
+	if req.HasData() && req.HasJSON() {
+		return ErrConflictingBody
+	}
+
+	req.SetURL(full)
+
+	if req.RequestTimeout() == 0 {
+		req.SetTimeout(DefaultTimeout)
+	}
+	if req.RequestHeader("User-Agent") == "" {
+		req.SetHeader("User-Agent", DefaultUserAgent)
+	}
+
+	return nil
+}
+
+func normalizeURL(raw string) (string, error) {
+	if raw == "" {
+		return "", ErrMissingURL
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMissingURL, err)
+	}
+	if !parsed.IsAbs() {
+		return "", fmt.Errorf("%w: %q is not absolute", ErrMissingURL, raw)
+	}
+	return parsed.String(), nil
+}
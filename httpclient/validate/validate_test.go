@@ -0,0 +1,82 @@
+package validate_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hammy/httpclient/validate"
+)
+
+type fakeRequest struct {
+	method  string
+	url     string
+	data    bool
+	json    bool
+	timeout time.Duration
+	headers map[string]string
+}
+
+func (r *fakeRequest) RequestMethod() string         { return r.method }
+func (r *fakeRequest) RequestURL() string            { return r.url }
+func (r *fakeRequest) SetURL(url string)             { r.url = url }
+func (r *fakeRequest) HasData() bool                 { return r.data }
+func (r *fakeRequest) HasJSON() bool                 { return r.json }
+func (r *fakeRequest) RequestTimeout() time.Duration { return r.timeout }
+func (r *fakeRequest) SetTimeout(d time.Duration)    { r.timeout = d }
+func (r *fakeRequest) RequestHeader(key string) string {
+	if r.headers == nil {
+		return ""
+	}
+	return r.headers[key]
+}
+func (r *fakeRequest) SetHeader(key, value string) {
+	if r.headers == nil {
+		r.headers = map[string]string{}
+	}
+	r.headers[key] = value
+}
+
+func TestRequestMissingURL(t *testing.T) {
+	req := &fakeRequest{method: "GET"}
+	if err := validate.Request(req); !errors.Is(err, validate.ErrMissingURL) {
+		t.Fatalf("got %v, want ErrMissingURL", err)
+	}
+}
+
+func TestRequestInvalidMethod(t *testing.T) {
+	req := &fakeRequest{method: "FETCH", url: "https://example.com"}
+	if err := validate.Request(req); !errors.Is(err, validate.ErrInvalidMethod) {
+		t.Fatalf("got %v, want ErrInvalidMethod", err)
+	}
+}
+
+func TestRequestConflictingBody(t *testing.T) {
+	req := &fakeRequest{method: "POST", url: "https://example.com", data: true, json: true}
+	if err := validate.Request(req); !errors.Is(err, validate.ErrConflictingBody) {
+		t.Fatalf("got %v, want ErrConflictingBody", err)
+	}
+}
+
+func TestRequestNormalizesURL(t *testing.T) {
+	req := &fakeRequest{method: "GET", url: "HTTP://example.com/path"}
+	if err := validate.Request(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.url != "http://example.com/path" {
+		t.Fatalf("url = %q, want scheme lowercased", req.url)
+	}
+}
+
+func TestRequestAppliesDefaults(t *testing.T) {
+	req := &fakeRequest{method: "GET", url: "https://example.com"}
+	if err := validate.Request(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.timeout != validate.DefaultTimeout {
+		t.Fatalf("timeout = %v, want %v", req.timeout, validate.DefaultTimeout)
+	}
+	if req.headers["User-Agent"] != validate.DefaultUserAgent {
+		t.Fatalf("User-Agent = %q", req.headers["User-Agent"])
+	}
+}
@@ -0,0 +1,46 @@
+// Package persistence provides concrete repository implementations for the
+// domain layer's repository interfaces.
+package persistence
+
+import (
+	"fmt"
+	"sync"
+
+	domain "hammy/domain/user"
+)
+
+// InMemoryUserRepository is a domain.UserRepository backed by a guarded
+// map, suitable for tests and local development.
+type InMemoryUserRepository struct {
+	mu    sync.Mutex
+	users map[string]*domain.User
+	seq   int
+}
+
+// NewInMemoryUserRepository returns an empty InMemoryUserRepository.
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{users: map[string]*domain.User{}}
+}
+
+func (r *InMemoryUserRepository) FindByID(id string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("persistence: user %q not found", id)
+	}
+	return u, nil
+}
+
+func (r *InMemoryUserRepository) Create(u *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u.ID == "" {
+		r.seq++
+		u.ID = fmt.Sprintf("%d", r.seq)
+	}
+	r.users[u.ID] = u
+	return nil
+}
@@ -0,0 +1,38 @@
+package persistence_test
+
+import (
+	"testing"
+
+	domain "hammy/domain/user"
+	"hammy/infrastructure/persistence"
+)
+
+func TestInMemoryUserRepositoryCreateAndFind(t *testing.T) {
+	repo := persistence.NewInMemoryUserRepository()
+
+	u, err := domain.NewUser("Ada", "ada@example.com")
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if err := repo.Create(u); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if u.ID == "" {
+		t.Fatal("expected Create to assign an ID")
+	}
+
+	got, err := repo.FindByID(u.ID)
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if got.Name != "Ada" {
+		t.Fatalf("got %q", got.Name)
+	}
+}
+
+func TestInMemoryUserRepositoryFindByIDNotFound(t *testing.T) {
+	repo := persistence.NewInMemoryUserRepository()
+	if _, err := repo.FindByID("missing"); err == nil {
+		t.Fatal("expected error for missing user")
+	}
+}
@@ -0,0 +1,52 @@
+// Package http adapts the user application service to HTTP handlers.
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	appuser "hammy/application/user"
+)
+
+// UserHandler exposes appuser.UserService over HTTP.
+type UserHandler struct {
+	service *appuser.UserService
+}
+
+// NewUserHandler returns a UserHandler backed by service.
+func NewUserHandler(service *appuser.UserService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+// Register handles POST requests that create a new user.
+func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dto, err := h.service.Register(in.Name, in.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dto)
+}
+
+// Get handles GET requests for a single user by ?id=.
+func (h *UserHandler) Get(w http.ResponseWriter, r *http.Request) {
+	dto, err := h.service.GetByID(r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dto)
+}
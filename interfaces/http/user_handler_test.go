@@ -0,0 +1,32 @@
+package http_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	appuser "hammy/application/user"
+	"hammy/infrastructure/persistence"
+	interfaceshttp "hammy/interfaces/http"
+)
+
+func TestUserHandlerRegisterAndGet(t *testing.T) {
+	service := appuser.NewUserService(persistence.NewInMemoryUserRepository())
+	handler := interfaceshttp.NewUserHandler(service)
+
+	registerReq := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":"Ada","email":"ada@example.com"}`))
+	registerResp := httptest.NewRecorder()
+	handler.Register(registerResp, registerReq)
+
+	if registerResp.Code != 200 {
+		t.Fatalf("Register status = %d, body = %s", registerResp.Code, registerResp.Body.String())
+	}
+
+	getReq := httptest.NewRequest("GET", "/users?id=1", nil)
+	getResp := httptest.NewRecorder()
+	handler.Get(getResp, getReq)
+
+	if getResp.Code != 200 {
+		t.Fatalf("Get status = %d, body = %s", getResp.Code, getResp.Body.String())
+	}
+}
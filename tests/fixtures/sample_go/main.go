@@ -2,36 +2,30 @@ package main
 
 import (
 	"fmt"
-	"net/http"
-)
-
-type UserService interface {
-	FindByID(id string) (*User, error)
-	Create(user *User) error
-}
-
-type User struct {
-	ID    string
-	Name  string
-	Email string
-}
 
-func NewUser(name string, email string) *User {
-	return &User{Name: name, Email: email}
-}
+	appuser "hammy/application/user"
+	"hammy/httpclient"
+	"hammy/infrastructure/persistence"
+)
 
-func (u *User) FullName() string {
-	return u.Name
+// newUserService wires the in-memory repository into the application
+// layer. User and UserService now live in domain/user and
+// application/user respectively; see MIGRATION.md.
+func newUserService() *appuser.UserService {
+	return appuser.NewUserService(persistence.NewInMemoryUserRepository())
 }
 
-func (u *User) Validate() error {
-	if u.Name == "" {
-		return fmt.Errorf("name required")
+func fetchData(url string) ([]byte, error) {
+	client := httpclient.New()
+	resp, err := client.NewRequest().Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("fetchData: %w", err)
 	}
-	return nil
+	defer resp.Close()
+
+	return resp.Bytes()
 }
 
-func fetchData(url string) {
-	resp, _ := http.Get("http://api.example.com/users")
-	defer resp.Body.Close()
+func main() {
+	newUserService()
 }